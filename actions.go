@@ -0,0 +1,86 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+)
+
+// Action represents a DigitalOcean API action. Actions are records of
+// operations performed on droplets, images, or other resources and are
+// returned by the action endpoints on those resources as well as by the
+// account-wide actions endpoint.
+type Action struct {
+	ID           int    `json:"id"`
+	Status       string `json:"status"`
+	Type         string `json:"type"`
+	StartedAt    string `json:"started_at"`
+	CompletedAt  string `json:"completed_at"`
+	ResourceID   int    `json:"resource_id"`
+	ResourceType string `json:"resource_type"`
+	RegionSlug   string `json:"region_slug,omitempty"`
+}
+
+// ActionsService handles communication with the account-wide action
+// related methods of the DigitalOcean API.
+type ActionsService interface {
+	List(ctx context.Context, opt *ListOptions) ([]Action, *Response, error)
+	Get(ctx context.Context, actionID int) (*Action, *Response, error)
+}
+
+// ActionsServiceOp handles communication with the action related methods of
+// the DigitalOcean API.
+type ActionsServiceOp struct {
+	client *Client
+}
+
+var _ ActionsService = &ActionsServiceOp{}
+
+type actionsRoot struct {
+	Actions []Action `json:"actions"`
+	Links   *Links   `json:"links,omitempty"`
+	Meta    *Meta    `json:"meta,omitempty"`
+}
+
+type actionRoot struct {
+	Action *Action `json:"action"`
+}
+
+// List all actions on the account.
+func (s *ActionsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Action, *Response, error) {
+	path, err := addOptions("actions", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(actionsRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	resp.Links = root.Links
+	resp.Meta = root.Meta
+
+	return root.Actions, resp, nil
+}
+
+// Get a single action by id.
+func (s *ActionsServiceOp) Get(ctx context.Context, actionID int) (*Action, *Response, error) {
+	path := fmt.Sprintf("actions/%d", actionID)
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(actionRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Action, resp, nil
+}
@@ -1,11 +1,18 @@
 package digitalocean
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
+	"sync"
+	"time"
 )
 
 const DIGITALOCEAN_API_URL = "https://api.digitalocean.com/v2"
@@ -21,6 +28,32 @@ type Client struct {
 	// HttpClient is the client to use. Default will be
 	// used if not provided.
 	Http *http.Client
+
+	// Services used for talking to the different parts of the
+	// DigitalOcean API.
+	Droplets       DropletsService
+	DropletActions DropletActionsService
+	Images         ImagesService
+	ImageActions   ImageActionsService
+	Keys           KeysService
+	Regions        RegionsService
+	Actions        ActionsService
+
+	// Retry configures automatic retry of failed requests. The zero value
+	// disables retries (MaxRetries of 0).
+	Retry RetryConfig
+
+	// Debug enables logging of every request and response through Logger.
+	Debug bool
+
+	// Logger receives the debug dumps when Debug is true. Defaults to a
+	// logger writing to stderr.
+	Logger Logger
+
+	// rateMu guards rate, which holds the most recently observed
+	// rate-limit status reported by the API.
+	rateMu sync.Mutex
+	rate   Rate
 }
 
 // DoError is the error format that they return
@@ -30,6 +63,103 @@ type DoError struct {
 	Message string `json:"message"`
 }
 
+// APIError represents a non-2xx response from the DigitalOcean API. It
+// carries the status code, the X-Request-Id header, and the raw body
+// alongside the decoded DoError fields, so callers can use errors.As to
+// branch on the status code instead of string-matching the message.
+type APIError struct {
+	StatusCode int
+	RequestID  string
+	Body       []byte
+
+	DoError
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API Error (%d): %s: %s", e.StatusCode, e.Id, e.Message)
+}
+
+// IsNotFound reports whether err is an *APIError for a 404 response.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is an *APIError for a 401 response.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsValidationError reports whether err is an *APIError for a 422 response.
+func IsValidationError(err error) bool {
+	return hasStatus(err, http.StatusUnprocessableEntity)
+}
+
+// IsRateLimited reports whether err is a *RateLimitError, or an *APIError
+// for a 429 response.
+func IsRateLimited(err error) bool {
+	var rateErr *RateLimitError
+	if errors.As(err, &rateErr) {
+		return true
+	}
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == status
+	}
+	return false
+}
+
+// Rate represents the account's API rate limit status as reported by the
+// RateLimit-* headers on every DigitalOcean API response.
+type Rate struct {
+	// Limit is the maximum number of requests allowed in the current window.
+	Limit int
+
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+
+	// Reset is when the current window expires and the limit refreshes.
+	Reset time.Time
+}
+
+// RateLimitError is returned when a request fails because the account has
+// exceeded its API rate limit. Reset indicates when the limit will refresh
+// so callers can back off deterministically instead of parsing the message
+// in a generic DoError.
+type RateLimitError struct {
+	Rate  Rate
+	Reset time.Time
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("digitalocean: rate limit exceeded, resets at %s", e.Reset.Format(time.RFC3339))
+}
+
+// Response wraps the standard http.Response returned from the DigitalOcean
+// API so that additional metadata (rate limits, pagination links, ...) can
+// be attached to it as the client grows.
+type Response struct {
+	*http.Response
+
+	// Links holds the pagination links for this response, populated on
+	// endpoints that return a "links" object in the response body.
+	Links *Links
+
+	// Meta holds additional response metadata (currently just the total
+	// item count), populated on endpoints that return a "meta" object.
+	Meta *Meta
+}
+
+// ListOptions specifies paging options for endpoints that support the
+// "page" and "per_page" query parameters.
+type ListOptions struct {
+	Page    int
+	PerPage int
+}
+
 // NewClient returns a new digitalocean client,
 // requires an authorization token. You can generate
 // an OAuth token by visiting the Apps & API section
@@ -39,53 +169,199 @@ func NewClient(token string) (*Client, error) {
 		Token: token,
 		URL:   DIGITALOCEAN_API_URL,
 	}
+
+	client.Droplets = &DropletsServiceOp{client: client}
+	client.DropletActions = &DropletActionsServiceOp{client: client}
+	client.Images = &ImagesServiceOp{client: client}
+	client.ImageActions = &ImageActionsServiceOp{client: client}
+	client.Keys = &KeysServiceOp{client: client}
+	client.Regions = &RegionsServiceOp{client: client}
+	client.Actions = &ActionsServiceOp{client: client}
+
 	return client, nil
 }
 
-// Creates a new request with the params
-func (c *Client) NewRequest(params map[string]string, method string, endpoint string) (*http.Request, error) {
-	p := url.Values{}
-	u, err := url.Parse(c.URL)
+// httpClient returns the HTTP client to use for requests, falling back
+// to http.DefaultClient when none was configured.
+func (c *Client) httpClient() *http.Client {
+	if c.Http != nil {
+		return c.Http
+	}
+	return http.DefaultClient
+}
 
+// NewRequest builds an API request for the given method and path. path may
+// carry its own query string (e.g. "droplets?tag_name=web"), which is
+// resolved against the client's base URL. When body is non-nil it is
+// JSON-encoded and sent as the request body. The returned request honors
+// ctx's cancellation/deadline.
+func (c *Client) NewRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
+	baseURL, err := url.Parse(c.URL + "/")
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing base URL: %s", err)
 	}
 
-	// Build up our request parameters
-	for k, v := range params {
-		p.Add(k, v)
+	rel, err := url.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing request path: %s", err)
 	}
 
-	// Add the params to our URL
-	u.RawQuery = p.Encode()
+	u := baseURL.ResolveReference(rel)
 
-	// Build the request
-	req, err := http.NewRequest(method, u.String(), nil)
+	var buf io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("Error encoding request body: %s", err)
+		}
+		buf = bytes.NewReader(encoded)
+	}
 
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, fmt.Errorf("Error creating request: %s", err)
 	}
 
-	// Add the authorization header
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 
 	return req, nil
+}
+
+// do sends a single attempt at an API request and, on success, decodes the
+// JSON response body into v. If v implements io.Writer, the raw response
+// body is streamed into it instead of being JSON-decoded. Do (in retry.go)
+// wraps this with automatic retry; do itself never retries.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	c.dumpRequest(req)
 
+	httpResp, err := c.httpClient().Do(req)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, err
+	}
+
+	c.dumpResponse(httpResp)
+
+	rate := parseRate(httpResp)
+	c.rateMu.Lock()
+	c.rate = rate
+	c.rateMu.Unlock()
+
+	if httpResp.StatusCode == http.StatusTooManyRequests {
+		defer httpResp.Body.Close()
+		return &Response{Response: httpResp}, &RateLimitError{Rate: rate, Reset: rate.Reset}
+	}
+
+	checkedResp, err := checkResp(httpResp, nil)
+	resp := &Response{Response: checkedResp}
+	if err != nil {
+		return resp, err
+	}
+
+	if v != nil {
+		if w, ok := v.(io.Writer); ok {
+			_, err := io.Copy(w, checkedResp.Body)
+			checkedResp.Body.Close()
+			return resp, err
+		}
+
+		if err := decodeBody(checkedResp, v); err != nil {
+			return resp, err
+		}
+
+		return resp, nil
+	}
+
+	// No destination for the body (e.g. the 204 from a Delete call) — drain
+	// and close it so the transport can reuse the underlying connection
+	// instead of leaking it.
+	io.Copy(io.Discard, checkedResp.Body)
+	checkedResp.Body.Close()
+
+	return resp, nil
 }
 
-// parseErr is used to take an error json resp
-// and return a single string for use in error messages
-func parseErr(resp *http.Response) error {
-	errBody := new(DoError)
+// GetRate returns the most recently observed rate limit status. It is
+// safe to call concurrently with in-flight requests.
+func (c *Client) GetRate() Rate {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	return c.rate
+}
+
+// parseRate extracts the RateLimit-* headers DigitalOcean sends on every
+// v2 API response.
+func parseRate(resp *http.Response) Rate {
+	var rate Rate
 
-	err := decodeBody(resp, errBody)
+	if limit := resp.Header.Get("RateLimit-Limit"); limit != "" {
+		rate.Limit, _ = strconv.Atoi(limit)
+	}
+
+	if remaining := resp.Header.Get("RateLimit-Remaining"); remaining != "" {
+		rate.Remaining, _ = strconv.Atoi(remaining)
+	}
+
+	if reset := resp.Header.Get("RateLimit-Reset"); reset != "" {
+		if epoch, err := strconv.ParseInt(reset, 10, 64); err == nil {
+			rate.Reset = time.Unix(epoch, 0)
+		}
+	}
+
+	return rate
+}
+
+// addOptions appends the "page" and "per_page" query parameters described
+// by opt onto path, leaving any query string path already carries intact.
+func addOptions(path string, opt *ListOptions) (string, error) {
+	if opt == nil {
+		return path, nil
+	}
+
+	u, err := url.Parse(path)
+	if err != nil {
+		return path, fmt.Errorf("Error parsing request path: %s", err)
+	}
+
+	q := u.Query()
+	if opt.Page > 0 {
+		q.Set("page", strconv.Itoa(opt.Page))
+	}
+	if opt.PerPage > 0 {
+		q.Set("per_page", strconv.Itoa(opt.PerPage))
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// parseErr reads a non-2xx response body and turns it into an *APIError
+// carrying the status code, request id, and raw body.
+func parseErr(resp *http.Response) error {
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
 
-	// if there was an error decoding the body, just return that
 	if err != nil {
 		return fmt.Errorf("Error parsing error body for non-200 request: %s", err)
 	}
 
-	return fmt.Errorf("API Error: %s: %s", errBody.Id, errBody.Message)
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		Body:       body,
+	}
+
+	// Best effort: the body isn't always valid DoError JSON, but the
+	// status code and raw body above are still useful even when it isn't.
+	json.Unmarshal(body, &apiErr.DoError)
+
+	return apiErr
 }
 
 // decodeBody is used to JSON decode a body
@@ -105,8 +381,8 @@ func decodeBody(resp *http.Response, out interface{}) error {
 }
 
 // checkResp wraps http.Client.Do() and verifies that the
-// request was successful. A non-200 request returns an error
-// formatted to included any validation problems or otherwise
+// request was successful. A non-2xx request returns an *APIError
+// describing the failure.
 func checkResp(resp *http.Response, err error) (*http.Response, error) {
 	// If the err is already there, there was an error higher
 	// up the chain, so just return that
@@ -114,14 +390,13 @@ func checkResp(resp *http.Response, err error) (*http.Response, error) {
 		return resp, err
 	}
 
-	// Verify that the request was sucessful
-	// 200 is the standard request code returned by the DO API,
-	// but 204 is used on successful DELETE requests
-	if resp.StatusCode != 200 || resp.StatusCode != 204 {
-		// Parse the err and retun it
-		return resp, parseErr(resp)
+	// Anything in the 2xx range is a success: 200 is the standard
+	// response code returned by the DO API, 204 is used on successful
+	// DELETE requests, and 202 shows up on some action endpoints.
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
 	}
 
-	// The request was succesful, so return a nil error
-	return resp, nil
+	// Parse the err and retun it
+	return resp, parseErr(resp)
 }
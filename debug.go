@@ -0,0 +1,67 @@
+package digitalocean
+
+import (
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Logger receives the request/response dumps produced when Client.Debug is
+// enabled. *log.Logger satisfies this interface.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+var defaultLogger Logger = log.New(os.Stderr, "", log.LstdFlags)
+
+var authHeaderRegexp = regexp.MustCompile(`(?m)^Authorization:.*$`)
+
+// logger returns the Logger to dump debug output to, falling back to a
+// stderr logger when none was configured.
+func (c *Client) logger() Logger {
+	if c.Logger != nil {
+		return c.Logger
+	}
+	return defaultLogger
+}
+
+// dumpRequest logs the outgoing request when Debug is enabled. The
+// Authorization header is always redacted, and the body is omitted for
+// multipart/form-data requests (image/snapshot uploads) so binary
+// payloads don't end up in logs.
+func (c *Client) dumpRequest(req *http.Request) {
+	if !c.Debug {
+		return
+	}
+
+	dump, err := httputil.DumpRequestOut(req, !isMultipart(req.Header.Get("Content-Type")))
+	if err != nil {
+		c.logger().Printf("digitalocean: error dumping request: %s", err)
+		return
+	}
+
+	c.logger().Printf("digitalocean: request:\n%s", authHeaderRegexp.ReplaceAll(dump, []byte("Authorization: REDACTED")))
+}
+
+// dumpResponse logs the response when Debug is enabled, omitting the body
+// for multipart/form-data responses for the same reason as dumpRequest.
+func (c *Client) dumpResponse(resp *http.Response) {
+	if !c.Debug {
+		return
+	}
+
+	dump, err := httputil.DumpResponse(resp, !isMultipart(resp.Header.Get("Content-Type")))
+	if err != nil {
+		c.logger().Printf("digitalocean: error dumping response: %s", err)
+		return
+	}
+
+	c.logger().Printf("digitalocean: response:\n%s", dump)
+}
+
+func isMultipart(contentType string) bool {
+	return strings.HasPrefix(contentType, "multipart/form-data")
+}
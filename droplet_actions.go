@@ -0,0 +1,123 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+)
+
+// DropletActionsService handles communication with the droplet action
+// related methods of the DigitalOcean API.
+type DropletActionsService interface {
+	Shutdown(ctx context.Context, dropletID int) (*Action, *Response, error)
+	PowerOff(ctx context.Context, dropletID int) (*Action, *Response, error)
+	PowerOn(ctx context.Context, dropletID int) (*Action, *Response, error)
+	PowerCycle(ctx context.Context, dropletID int) (*Action, *Response, error)
+	Reboot(ctx context.Context, dropletID int) (*Action, *Response, error)
+	Restore(ctx context.Context, dropletID, imageID int) (*Action, *Response, error)
+	Resize(ctx context.Context, dropletID int, sizeSlug string, resizeDisk bool) (*Action, *Response, error)
+	Rename(ctx context.Context, dropletID int, name string) (*Action, *Response, error)
+	Snapshot(ctx context.Context, dropletID int, name string) (*Action, *Response, error)
+	EnableBackups(ctx context.Context, dropletID int) (*Action, *Response, error)
+	DisableBackups(ctx context.Context, dropletID int) (*Action, *Response, error)
+	PasswordReset(ctx context.Context, dropletID int) (*Action, *Response, error)
+	EnableIPv6(ctx context.Context, dropletID int) (*Action, *Response, error)
+	EnablePrivateNetworking(ctx context.Context, dropletID int) (*Action, *Response, error)
+	Get(ctx context.Context, dropletID, actionID int) (*Action, *Response, error)
+}
+
+// DropletActionsServiceOp handles communication with the droplet action
+// related methods of the DigitalOcean API.
+type DropletActionsServiceOp struct {
+	client *Client
+}
+
+var _ DropletActionsService = &DropletActionsServiceOp{}
+
+func (s *DropletActionsServiceOp) doAction(ctx context.Context, dropletID int, req interface{}) (*Action, *Response, error) {
+	path := fmt.Sprintf("droplets/%d/actions", dropletID)
+	httpReq, err := s.client.NewRequest(ctx, "POST", path, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(actionRoot)
+	resp, err := s.client.Do(ctx, httpReq, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Action, resp, nil
+}
+
+func (s *DropletActionsServiceOp) Shutdown(ctx context.Context, dropletID int) (*Action, *Response, error) {
+	return s.doAction(ctx, dropletID, map[string]interface{}{"type": "shutdown"})
+}
+
+func (s *DropletActionsServiceOp) PowerOff(ctx context.Context, dropletID int) (*Action, *Response, error) {
+	return s.doAction(ctx, dropletID, map[string]interface{}{"type": "power_off"})
+}
+
+func (s *DropletActionsServiceOp) PowerOn(ctx context.Context, dropletID int) (*Action, *Response, error) {
+	return s.doAction(ctx, dropletID, map[string]interface{}{"type": "power_on"})
+}
+
+func (s *DropletActionsServiceOp) PowerCycle(ctx context.Context, dropletID int) (*Action, *Response, error) {
+	return s.doAction(ctx, dropletID, map[string]interface{}{"type": "power_cycle"})
+}
+
+func (s *DropletActionsServiceOp) Reboot(ctx context.Context, dropletID int) (*Action, *Response, error) {
+	return s.doAction(ctx, dropletID, map[string]interface{}{"type": "reboot"})
+}
+
+func (s *DropletActionsServiceOp) Restore(ctx context.Context, dropletID, imageID int) (*Action, *Response, error) {
+	return s.doAction(ctx, dropletID, map[string]interface{}{"type": "restore", "image": imageID})
+}
+
+func (s *DropletActionsServiceOp) Resize(ctx context.Context, dropletID int, sizeSlug string, resizeDisk bool) (*Action, *Response, error) {
+	return s.doAction(ctx, dropletID, map[string]interface{}{"type": "resize", "size": sizeSlug, "disk": resizeDisk})
+}
+
+func (s *DropletActionsServiceOp) Rename(ctx context.Context, dropletID int, name string) (*Action, *Response, error) {
+	return s.doAction(ctx, dropletID, map[string]interface{}{"type": "rename", "name": name})
+}
+
+func (s *DropletActionsServiceOp) Snapshot(ctx context.Context, dropletID int, name string) (*Action, *Response, error) {
+	return s.doAction(ctx, dropletID, map[string]interface{}{"type": "snapshot", "name": name})
+}
+
+func (s *DropletActionsServiceOp) EnableBackups(ctx context.Context, dropletID int) (*Action, *Response, error) {
+	return s.doAction(ctx, dropletID, map[string]interface{}{"type": "enable_backups"})
+}
+
+func (s *DropletActionsServiceOp) DisableBackups(ctx context.Context, dropletID int) (*Action, *Response, error) {
+	return s.doAction(ctx, dropletID, map[string]interface{}{"type": "disable_backups"})
+}
+
+func (s *DropletActionsServiceOp) PasswordReset(ctx context.Context, dropletID int) (*Action, *Response, error) {
+	return s.doAction(ctx, dropletID, map[string]interface{}{"type": "password_reset"})
+}
+
+func (s *DropletActionsServiceOp) EnableIPv6(ctx context.Context, dropletID int) (*Action, *Response, error) {
+	return s.doAction(ctx, dropletID, map[string]interface{}{"type": "enable_ipv6"})
+}
+
+func (s *DropletActionsServiceOp) EnablePrivateNetworking(ctx context.Context, dropletID int) (*Action, *Response, error) {
+	return s.doAction(ctx, dropletID, map[string]interface{}{"type": "enable_private_networking"})
+}
+
+// Get a single droplet action by id.
+func (s *DropletActionsServiceOp) Get(ctx context.Context, dropletID, actionID int) (*Action, *Response, error) {
+	path := fmt.Sprintf("droplets/%d/actions/%d", dropletID, actionID)
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(actionRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Action, resp, nil
+}
@@ -0,0 +1,207 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Droplet represents a DigitalOcean droplet.
+type Droplet struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Memory      int       `json:"memory"`
+	Vcpus       int       `json:"vcpus"`
+	Disk        int       `json:"disk"`
+	Locked      bool      `json:"locked"`
+	Status      string    `json:"status"`
+	Kernel      *Kernel   `json:"kernel,omitempty"`
+	CreatedAt   string    `json:"created_at"`
+	Features    []string  `json:"features"`
+	BackupIDs   []int     `json:"backup_ids"`
+	SnapshotIDs []int     `json:"snapshot_ids"`
+	ActionIDs   []int     `json:"action_ids"`
+	Image       *Image    `json:"image,omitempty"`
+	VolumeIDs   []string  `json:"volume_ids"`
+	Size        *Size     `json:"size,omitempty"`
+	SizeSlug    string    `json:"size_slug"`
+	Networks    *Networks `json:"networks,omitempty"`
+	Region      *Region   `json:"region,omitempty"`
+	Tags        []string  `json:"tags"`
+}
+
+// Kernel describes a kernel available to a droplet.
+type Kernel struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Size describes a droplet size.
+type Size struct {
+	Slug         string   `json:"slug"`
+	Memory       int      `json:"memory"`
+	Vcpus        int      `json:"vcpus"`
+	Disk         int      `json:"disk"`
+	PriceMonthly float64  `json:"price_monthly"`
+	PriceHourly  float64  `json:"price_hourly"`
+	Regions      []string `json:"regions"`
+	Available    bool     `json:"available"`
+}
+
+// Networks holds the IPv4 and IPv6 networks attached to a droplet.
+type Networks struct {
+	V4 []NetworkV4 `json:"v4"`
+	V6 []NetworkV6 `json:"v6"`
+}
+
+// NetworkV4 describes a single IPv4 network interface.
+type NetworkV4 struct {
+	IPAddress string `json:"ip_address"`
+	Netmask   string `json:"netmask"`
+	Gateway   string `json:"gateway"`
+	Type      string `json:"type"`
+}
+
+// NetworkV6 describes a single IPv6 network interface.
+type NetworkV6 struct {
+	IPAddress string `json:"ip_address"`
+	Netmask   int    `json:"netmask"`
+	Gateway   string `json:"gateway"`
+	Type      string `json:"type"`
+}
+
+// DropletCreateImage identifies the image to boot a new droplet from,
+// either by numeric id or by slug (e.g. "ubuntu-20-04-x64").
+type DropletCreateImage struct {
+	ID   int    `json:"id,omitempty"`
+	Slug string `json:"slug,omitempty"`
+}
+
+// DropletCreateSSHKey identifies an SSH key to add to a new droplet,
+// either by numeric id or by fingerprint.
+type DropletCreateSSHKey struct {
+	ID          int    `json:"id,omitempty"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+// DropletCreateRequest represents the body of a droplet create call.
+type DropletCreateRequest struct {
+	Name              string                `json:"name"`
+	Region            string                `json:"region"`
+	Size              string                `json:"size"`
+	Image             DropletCreateImage    `json:"image"`
+	SSHKeys           []DropletCreateSSHKey `json:"ssh_keys,omitempty"`
+	Backups           bool                  `json:"backups,omitempty"`
+	IPv6              bool                  `json:"ipv6,omitempty"`
+	PrivateNetworking bool                  `json:"private_networking,omitempty"`
+	Monitoring        bool                  `json:"monitoring,omitempty"`
+	UserData          string                `json:"user_data,omitempty"`
+	Tags              []string              `json:"tags,omitempty"`
+}
+
+// DropletsService handles communication with the droplet related methods
+// of the DigitalOcean API.
+type DropletsService interface {
+	List(ctx context.Context, opt *ListOptions) ([]Droplet, *Response, error)
+	Get(ctx context.Context, dropletID int) (*Droplet, *Response, error)
+	Create(ctx context.Context, createRequest *DropletCreateRequest) (*Droplet, *Response, error)
+	Delete(ctx context.Context, dropletID int) (*Response, error)
+	DeleteByTag(ctx context.Context, tag string) (*Response, error)
+}
+
+// DropletsServiceOp handles communication with the droplet related methods
+// of the DigitalOcean API.
+type DropletsServiceOp struct {
+	client *Client
+}
+
+var _ DropletsService = &DropletsServiceOp{}
+
+type dropletsRoot struct {
+	Droplets []Droplet `json:"droplets"`
+	Links    *Links    `json:"links,omitempty"`
+	Meta     *Meta     `json:"meta,omitempty"`
+}
+
+type dropletRoot struct {
+	Droplet *Droplet `json:"droplet"`
+}
+
+// List all droplets on the account.
+func (s *DropletsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Droplet, *Response, error) {
+	path, err := addOptions("droplets", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(dropletsRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	resp.Links = root.Links
+	resp.Meta = root.Meta
+
+	return root.Droplets, resp, nil
+}
+
+// Get a single droplet by id.
+func (s *DropletsServiceOp) Get(ctx context.Context, dropletID int) (*Droplet, *Response, error) {
+	path := fmt.Sprintf("droplets/%d", dropletID)
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(dropletRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Droplet, resp, nil
+}
+
+// Create a new droplet.
+func (s *DropletsServiceOp) Create(ctx context.Context, createRequest *DropletCreateRequest) (*Droplet, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "POST", "droplets", createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(dropletRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Droplet, resp, nil
+}
+
+// Delete a single droplet by id.
+func (s *DropletsServiceOp) Delete(ctx context.Context, dropletID int) (*Response, error) {
+	path := fmt.Sprintf("droplets/%d", dropletID)
+	req, err := s.client.NewRequest(ctx, "DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DeleteByTag deletes every droplet carrying the given tag.
+func (s *DropletsServiceOp) DeleteByTag(ctx context.Context, tag string) (*Response, error) {
+	path := fmt.Sprintf("droplets?tag_name=%s", url.QueryEscape(tag))
+	req, err := s.client.NewRequest(ctx, "DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
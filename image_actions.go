@@ -0,0 +1,65 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+)
+
+// ImageActionsService handles communication with the image action related
+// methods of the DigitalOcean API.
+type ImageActionsService interface {
+	Convert(ctx context.Context, imageID int) (*Action, *Response, error)
+	Transfer(ctx context.Context, imageID int, regionSlug string) (*Action, *Response, error)
+	Get(ctx context.Context, imageID, actionID int) (*Action, *Response, error)
+}
+
+// ImageActionsServiceOp handles communication with the image action
+// related methods of the DigitalOcean API.
+type ImageActionsServiceOp struct {
+	client *Client
+}
+
+var _ ImageActionsService = &ImageActionsServiceOp{}
+
+func (s *ImageActionsServiceOp) doAction(ctx context.Context, imageID int, req interface{}) (*Action, *Response, error) {
+	path := fmt.Sprintf("images/%d/actions", imageID)
+	httpReq, err := s.client.NewRequest(ctx, "POST", path, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(actionRoot)
+	resp, err := s.client.Do(ctx, httpReq, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Action, resp, nil
+}
+
+// Convert an image (typically a backup) into a snapshot.
+func (s *ImageActionsServiceOp) Convert(ctx context.Context, imageID int) (*Action, *Response, error) {
+	return s.doAction(ctx, imageID, map[string]interface{}{"type": "convert"})
+}
+
+// Transfer an image to another region.
+func (s *ImageActionsServiceOp) Transfer(ctx context.Context, imageID int, regionSlug string) (*Action, *Response, error) {
+	return s.doAction(ctx, imageID, map[string]interface{}{"type": "transfer", "region": regionSlug})
+}
+
+// Get a single image action by id.
+func (s *ImageActionsServiceOp) Get(ctx context.Context, imageID, actionID int) (*Action, *Response, error) {
+	path := fmt.Sprintf("images/%d/actions/%d", imageID, actionID)
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(actionRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Action, resp, nil
+}
@@ -0,0 +1,134 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+)
+
+// Image represents a DigitalOcean image, used as the base for new droplets
+// or captured as a snapshot/backup of an existing one.
+type Image struct {
+	ID            int      `json:"id"`
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	Distribution  string   `json:"distribution"`
+	Slug          string   `json:"slug"`
+	Public        bool     `json:"public"`
+	Regions       []string `json:"regions"`
+	MinDiskSize   int      `json:"min_disk_size"`
+	SizeGigabytes float64  `json:"size_gigabytes"`
+	CreatedAt     string   `json:"created_at"`
+	Status        string   `json:"status"`
+	ErrorMessage  string   `json:"error_message,omitempty"`
+}
+
+// ImageUpdateRequest represents the body of an image update call.
+type ImageUpdateRequest struct {
+	Name string `json:"name"`
+}
+
+// ImagesService handles communication with the image related methods of
+// the DigitalOcean API.
+type ImagesService interface {
+	List(ctx context.Context, opt *ListOptions) ([]Image, *Response, error)
+	Get(ctx context.Context, imageID int) (*Image, *Response, error)
+	GetBySlug(ctx context.Context, slug string) (*Image, *Response, error)
+	Update(ctx context.Context, imageID int, updateRequest *ImageUpdateRequest) (*Image, *Response, error)
+	Delete(ctx context.Context, imageID int) (*Response, error)
+}
+
+// ImagesServiceOp handles communication with the image related methods of
+// the DigitalOcean API.
+type ImagesServiceOp struct {
+	client *Client
+}
+
+var _ ImagesService = &ImagesServiceOp{}
+
+type imagesRoot struct {
+	Images []Image `json:"images"`
+	Links  *Links  `json:"links,omitempty"`
+	Meta   *Meta   `json:"meta,omitempty"`
+}
+
+type imageRoot struct {
+	Image *Image `json:"image"`
+}
+
+// List all images available to the account.
+func (s *ImagesServiceOp) List(ctx context.Context, opt *ListOptions) ([]Image, *Response, error) {
+	path, err := addOptions("images", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(imagesRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	resp.Links = root.Links
+	resp.Meta = root.Meta
+
+	return root.Images, resp, nil
+}
+
+// Get a single image by id.
+func (s *ImagesServiceOp) Get(ctx context.Context, imageID int) (*Image, *Response, error) {
+	path := fmt.Sprintf("images/%d", imageID)
+	return s.get(ctx, path)
+}
+
+// GetBySlug fetches a single public image by its slug (e.g. "ubuntu-20-04-x64").
+func (s *ImagesServiceOp) GetBySlug(ctx context.Context, slug string) (*Image, *Response, error) {
+	path := fmt.Sprintf("images/%s", slug)
+	return s.get(ctx, path)
+}
+
+func (s *ImagesServiceOp) get(ctx context.Context, path string) (*Image, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(imageRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Image, resp, nil
+}
+
+// Update an image's name.
+func (s *ImagesServiceOp) Update(ctx context.Context, imageID int, updateRequest *ImageUpdateRequest) (*Image, *Response, error) {
+	path := fmt.Sprintf("images/%d", imageID)
+	req, err := s.client.NewRequest(ctx, "PUT", path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(imageRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Image, resp, nil
+}
+
+// Delete a single image by id.
+func (s *ImagesServiceOp) Delete(ctx context.Context, imageID int) (*Response, error) {
+	path := fmt.Sprintf("images/%d", imageID)
+	req, err := s.client.NewRequest(ctx, "DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,148 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+)
+
+// Key represents a DigitalOcean SSH key that can be added to new droplets.
+type Key struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Fingerprint string `json:"fingerprint"`
+	PublicKey   string `json:"public_key"`
+}
+
+// KeyCreateRequest represents the body of a key create call.
+type KeyCreateRequest struct {
+	Name      string `json:"name"`
+	PublicKey string `json:"public_key"`
+}
+
+// KeyUpdateRequest represents the body of a key update call.
+type KeyUpdateRequest struct {
+	Name string `json:"name"`
+}
+
+// KeysService handles communication with the SSH key related methods of
+// the DigitalOcean API.
+type KeysService interface {
+	List(ctx context.Context, opt *ListOptions) ([]Key, *Response, error)
+	Get(ctx context.Context, keyID int) (*Key, *Response, error)
+	GetByFingerprint(ctx context.Context, fingerprint string) (*Key, *Response, error)
+	Create(ctx context.Context, createRequest *KeyCreateRequest) (*Key, *Response, error)
+	Update(ctx context.Context, keyID int, updateRequest *KeyUpdateRequest) (*Key, *Response, error)
+	Delete(ctx context.Context, keyID int) (*Response, error)
+}
+
+// KeysServiceOp handles communication with the SSH key related methods of
+// the DigitalOcean API.
+type KeysServiceOp struct {
+	client *Client
+}
+
+var _ KeysService = &KeysServiceOp{}
+
+type keysRoot struct {
+	SSHKeys []Key  `json:"ssh_keys"`
+	Links   *Links `json:"links,omitempty"`
+	Meta    *Meta  `json:"meta,omitempty"`
+}
+
+type keyRoot struct {
+	SSHKey *Key `json:"ssh_key"`
+}
+
+// List all SSH keys on the account.
+func (s *KeysServiceOp) List(ctx context.Context, opt *ListOptions) ([]Key, *Response, error) {
+	path, err := addOptions("account/keys", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(keysRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	resp.Links = root.Links
+	resp.Meta = root.Meta
+
+	return root.SSHKeys, resp, nil
+}
+
+// Get a single SSH key by id.
+func (s *KeysServiceOp) Get(ctx context.Context, keyID int) (*Key, *Response, error) {
+	path := fmt.Sprintf("account/keys/%d", keyID)
+	return s.get(ctx, path)
+}
+
+// GetByFingerprint fetches a single SSH key by its fingerprint.
+func (s *KeysServiceOp) GetByFingerprint(ctx context.Context, fingerprint string) (*Key, *Response, error) {
+	path := fmt.Sprintf("account/keys/%s", fingerprint)
+	return s.get(ctx, path)
+}
+
+func (s *KeysServiceOp) get(ctx context.Context, path string) (*Key, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(keyRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.SSHKey, resp, nil
+}
+
+// Create a new SSH key.
+func (s *KeysServiceOp) Create(ctx context.Context, createRequest *KeyCreateRequest) (*Key, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "POST", "account/keys", createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(keyRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.SSHKey, resp, nil
+}
+
+// Update an SSH key's name.
+func (s *KeysServiceOp) Update(ctx context.Context, keyID int, updateRequest *KeyUpdateRequest) (*Key, *Response, error) {
+	path := fmt.Sprintf("account/keys/%d", keyID)
+	req, err := s.client.NewRequest(ctx, "PUT", path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(keyRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.SSHKey, resp, nil
+}
+
+// Delete a single SSH key by id.
+func (s *KeysServiceOp) Delete(ctx context.Context, keyID int) (*Response, error) {
+	path := fmt.Sprintf("account/keys/%d", keyID)
+	req, err := s.client.NewRequest(ctx, "DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
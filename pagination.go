@@ -0,0 +1,135 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Links holds the pagination links DigitalOcean returns alongside list
+// endpoints.
+type Links struct {
+	Pages *LinksPages `json:"pages,omitempty"`
+}
+
+// LinksPages holds the individual pagination link URLs.
+type LinksPages struct {
+	First string `json:"first,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+	Next  string `json:"next,omitempty"`
+	Last  string `json:"last,omitempty"`
+}
+
+// Meta holds metadata returned alongside list responses.
+type Meta struct {
+	Total int `json:"total"`
+}
+
+// CurrentPage returns the page number of the response these links came
+// from. The API doesn't return it directly, so it's inferred from the
+// next/last links.
+func (l *Links) CurrentPage() (int, error) {
+	if l == nil || l.Pages == nil {
+		return 1, nil
+	}
+
+	if l.Pages.Next != "" {
+		next, err := pageForURL(l.Pages.Next)
+		if err != nil {
+			return 0, err
+		}
+		return next - 1, nil
+	}
+
+	if l.Pages.Last != "" {
+		return pageForURL(l.Pages.Last)
+	}
+
+	return 1, nil
+}
+
+// NextPage returns the page number of the next page, or 0 if this is the
+// last page.
+func (l *Links) NextPage() (int, error) {
+	if l == nil || l.Pages == nil || l.Pages.Next == "" {
+		return 0, nil
+	}
+	return pageForURL(l.Pages.Next)
+}
+
+// PrevPage returns the page number of the previous page, or 0 if this is
+// the first page.
+func (l *Links) PrevPage() (int, error) {
+	if l == nil || l.Pages == nil || l.Pages.Prev == "" {
+		return 0, nil
+	}
+	return pageForURL(l.Pages.Prev)
+}
+
+// LastPage returns the page number of the last page, or 0 if unknown.
+func (l *Links) LastPage() (int, error) {
+	if l == nil || l.Pages == nil || l.Pages.Last == "" {
+		return 0, nil
+	}
+	return pageForURL(l.Pages.Last)
+}
+
+// pageForURL extracts the "page" query parameter from a pagination link.
+func pageForURL(rawURL string) (int, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return 0, fmt.Errorf("Error parsing pagination URL: %s", err)
+	}
+
+	page := u.Query().Get("page")
+	if page == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(page)
+}
+
+// ListAll walks every page of a paginated list endpoint, calling fetch
+// once per page until there are no more pages left. opt seeds the first
+// request (e.g. to set PerPage); pass nil to use the API's defaults.
+// fetch is expected to be a closure over a service's List method, e.g.:
+//
+//	var droplets []Droplet
+//	opt := &digitalocean.ListOptions{PerPage: 200}
+//	err := client.ListAll(ctx, opt, func(opt *digitalocean.ListOptions) (*digitalocean.Response, error) {
+//		page, resp, err := client.Droplets.List(ctx, opt)
+//		droplets = append(droplets, page...)
+//		return resp, err
+//	})
+func (c *Client) ListAll(ctx context.Context, opt *ListOptions, fetch func(*ListOptions) (*Response, error)) error {
+	if opt == nil {
+		opt = &ListOptions{}
+	}
+	if opt.Page == 0 {
+		opt.Page = 1
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		resp, err := fetch(opt)
+		if err != nil {
+			return err
+		}
+
+		next, err := resp.Links.NextPage()
+		if err != nil {
+			return err
+		}
+		if next == 0 {
+			return nil
+		}
+
+		opt.Page = next
+	}
+}
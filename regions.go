@@ -0,0 +1,55 @@
+package digitalocean
+
+import "context"
+
+// Region represents a DigitalOcean datacenter region.
+type Region struct {
+	Slug      string   `json:"slug"`
+	Name      string   `json:"name"`
+	Sizes     []string `json:"sizes"`
+	Available bool     `json:"available"`
+	Features  []string `json:"features"`
+}
+
+// RegionsService handles communication with the region related methods of
+// the DigitalOcean API.
+type RegionsService interface {
+	List(ctx context.Context, opt *ListOptions) ([]Region, *Response, error)
+}
+
+// RegionsServiceOp handles communication with the region related methods of
+// the DigitalOcean API.
+type RegionsServiceOp struct {
+	client *Client
+}
+
+var _ RegionsService = &RegionsServiceOp{}
+
+type regionsRoot struct {
+	Regions []Region `json:"regions"`
+	Links   *Links   `json:"links,omitempty"`
+	Meta    *Meta    `json:"meta,omitempty"`
+}
+
+// List all regions available to the account.
+func (s *RegionsServiceOp) List(ctx context.Context, opt *ListOptions) ([]Region, *Response, error) {
+	path, err := addOptions("regions", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(regionsRoot)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	resp.Links = root.Links
+	resp.Meta = root.Meta
+
+	return root.Regions, resp, nil
+}
@@ -0,0 +1,190 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryConfig controls how Do retries a failed request. MaxRetries of 0
+// (the zero value) disables retries entirely.
+type RetryConfig struct {
+	// MaxRetries is the maximum number of additional attempts after the
+	// first one.
+	MaxRetries int
+
+	// InitialInterval is the backoff before the first retry. Defaults to
+	// one second if unset.
+	InitialInterval time.Duration
+
+	// MaxInterval caps the computed backoff. Zero means uncapped.
+	MaxInterval time.Duration
+
+	// Multiplier is applied to the backoff after every attempt. Defaults
+	// to 2 if less than or equal to 1.
+	Multiplier float64
+
+	// RandomizationFactor adds jitter: the computed backoff is randomized
+	// within +/- this fraction of itself.
+	RandomizationFactor float64
+}
+
+// RetryError is returned by Do when a request was retried at least once
+// and the last attempt still failed, whether because the retry budget ran
+// out or because that attempt failed in a way that is not retried. It
+// wraps the last error observed, and carries the final response (if any)
+// for diagnostics.
+type RetryError struct {
+	Attempts int
+	Response *Response
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("digitalocean: request failed after %d retries: %s", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// Do sends an API request, retrying on 429 and 5xx responses (and
+// transient network errors) according to c.Retry. On success it behaves
+// like a single call to the internal do: the JSON response is decoded
+// into v, or streamed to v if v implements io.Writer. If the request was
+// retried at least once and still fails, the last error is returned
+// wrapped in a *RetryError.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	var resp *Response
+	var err error
+
+	attempt := 0
+	for {
+		resp, err = c.do(ctx, req, v)
+
+		if !c.shouldRetry(attempt, resp, err) {
+			break
+		}
+
+		wait := c.retryWait(attempt, resp)
+
+		select {
+		case <-ctx.Done():
+			return resp, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				break
+			}
+			req.Body = body
+		}
+
+		attempt++
+	}
+
+	if err != nil && attempt > 0 {
+		return resp, &RetryError{Attempts: attempt, Response: resp, Err: err}
+	}
+
+	return resp, err
+}
+
+// shouldRetry reports whether attempt (0-indexed) should be followed by
+// another try, given the outcome of the attempt just made.
+func (c *Client) shouldRetry(attempt int, resp *Response, err error) bool {
+	if attempt >= c.Retry.MaxRetries {
+		return false
+	}
+
+	if resp == nil {
+		// A network-level failure with no response at all is transient.
+		return err != nil
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode >= 500 && resp.StatusCode <= 599:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryWait computes how long to wait before the next attempt, honoring
+// Retry-After / RateLimit-Reset on 429s and falling back to exponential
+// backoff with jitter otherwise.
+func (c *Client) retryWait(attempt int, resp *Response) time.Duration {
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		if wait, ok := retryAfterWait(resp.Response); ok {
+			return wait
+		}
+		if reset := parseRate(resp.Response).Reset; !reset.IsZero() {
+			if until := time.Until(reset); until > 0 {
+				return until
+			}
+		}
+	}
+
+	return backoffWait(c.Retry, attempt)
+}
+
+// retryAfterWait parses the Retry-After header, which the DigitalOcean API
+// may send as either a number of seconds or an HTTP-date.
+func retryAfterWait(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if when, err := http.ParseTime(v); err == nil {
+		if until := time.Until(when); until > 0 {
+			return until, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// backoffWait computes an exponential backoff with jitter for the given
+// attempt (0-indexed), per cfg.
+func backoffWait(cfg RetryConfig, attempt int) time.Duration {
+	initial := cfg.InitialInterval
+	if initial <= 0 {
+		initial = time.Second
+	}
+
+	multiplier := cfg.Multiplier
+	if multiplier <= 1 {
+		multiplier = 2
+	}
+
+	wait := float64(initial) * math.Pow(multiplier, float64(attempt))
+
+	if cfg.MaxInterval > 0 && wait > float64(cfg.MaxInterval) {
+		wait = float64(cfg.MaxInterval)
+	}
+
+	if cfg.RandomizationFactor > 0 {
+		delta := cfg.RandomizationFactor * wait
+		wait = wait - delta + rand.Float64()*2*delta
+	}
+
+	if wait < 0 {
+		wait = 0
+	}
+
+	return time.Duration(wait)
+}